@@ -0,0 +1,26 @@
+// lru project cost.go
+package lru
+
+// NewWithCost creates a new Cache whose capacity is governed by cost rather
+// than entry count. Each Set computes the entry's cost via coster, and the
+// cache evicts from the tail until currentCost+newCost <= maxCost.
+func NewWithCost(maxCost int64, coster func(key string, val interface{}) int64) (*Cache, error) {
+	typed, err := NewTypedWithCost[string, interface{}](maxCost, coster)
+	if err != nil {
+		return nil, err
+	}
+	Cache := new(Cache)
+	Cache.typed = typed
+	return Cache, nil
+}
+
+// ByteSizeCoster is a coster for []byte values that costs an entry by the
+// number of bytes it holds, making "cache N MB of blobs" trivial via
+// lru.NewWithCost(maxBytes, lru.ByteSizeCoster).
+func ByteSizeCoster(key string, val interface{}) int64 {
+	b, ok := val.([]byte)
+	if !ok {
+		return 0
+	}
+	return int64(len(b))
+}
@@ -0,0 +1,94 @@
+// lru project cost_test.go
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_costEvictsToStayUnderMaxCost(t *testing.T) {
+	l, err := NewWithCost(10, ByteSizeCoster)
+	assert.Nil(t, err)
+
+	l.Set("a", []byte("12345")) // cost 5
+	l.Set("b", []byte("12345")) // cost 5, total 10, at the limit
+
+	_, found := l.Get("a")
+	assert.True(t, found)
+
+	// "a" was just touched by Get, so "b" is now the least recently used
+	// and is the one evicted to make room for "c".
+	l.Set("c", []byte("12345")) // cost 5, must evict "b" to fit
+
+	_, found = l.Get("a")
+	assert.True(t, found)
+	_, found = l.Get("b")
+	assert.False(t, found)
+	_, found = l.Get("c")
+	assert.True(t, found)
+
+	stats := l.Stats()
+	assert.Equal(t, int64(10), stats.Cost)
+	assert.Equal(t, int64(15), stats.CostAdded)
+	assert.Equal(t, int64(5), stats.CostEvicted)
+}
+
+func Test_costUpdateReplacesCost(t *testing.T) {
+	l, err := NewWithCost(10, ByteSizeCoster)
+	assert.Nil(t, err)
+
+	l.Set("a", []byte("12345")) // cost 5
+	l.Set("a", []byte("1234567890"))
+
+	assert.Equal(t, int64(10), l.Stats().Cost)
+}
+
+func Test_costUpdateSameCostDoesNotEvictNeighbors(t *testing.T) {
+	l, err := NewWithCost(100, ByteSizeCoster)
+	assert.Nil(t, err)
+
+	l.Set("a", make([]byte, 10))
+	l.Set("b", make([]byte, 40))
+	l.Set("c", make([]byte, 50)) // cache full at cost 100
+
+	l.Set("c", make([]byte, 50)) // same cost, should not evict "a" or "b"
+
+	_, found := l.Get("a")
+	assert.True(t, found)
+	_, found = l.Get("b")
+	assert.True(t, found)
+	_, found = l.Get("c")
+	assert.True(t, found)
+
+	assert.Equal(t, int64(100), l.Stats().Cost)
+}
+
+func Test_costUpdateOfEvictionVictimIsNotEvicted(t *testing.T) {
+	l, err := NewWithCost(15, ByteSizeCoster)
+	assert.Nil(t, err)
+
+	l.Set("a", make([]byte, 5))
+	l.Set("b", make([]byte, 5))
+	l.Set("c", make([]byte, 5)) // cache full at cost 15, "a" is the LRU tail
+
+	// Growing "a" makes it the largest entry and forces eviction of its
+	// neighbors, but "a" itself - the entry being updated - must survive
+	// and its previous value must be returned.
+	prev := l.Set("a", make([]byte, 15))
+	assert.Equal(t, 5, len(prev.([]byte)))
+
+	_, found := l.Get("a")
+	assert.True(t, found)
+	_, found = l.Get("b")
+	assert.False(t, found)
+	_, found = l.Get("c")
+	assert.False(t, found)
+
+	assert.Equal(t, int64(15), l.Stats().Cost)
+}
+
+func Test_byteSizeCosterIgnoresNonBytes(t *testing.T) {
+	assert.Equal(t, int64(0), ByteSizeCoster("a", "not bytes"))
+	assert.Equal(t, int64(3), ByteSizeCoster("a", []byte("abc")))
+}
@@ -0,0 +1,102 @@
+// lru project events.go
+package lru
+
+// EventKind identifies why an Event was published.
+type EventKind int
+
+const (
+	// EventSet means an entry was inserted or updated via Set.
+	EventSet EventKind = iota
+	// EventRemove means an entry was removed via Remove.
+	EventRemove
+	// EventEvict means an entry was evicted to make room for a new one.
+	EventEvict
+	// EventExpire means an entry was removed because its TTL had passed.
+	EventExpire
+)
+
+// TypedEvent is published to every subscriber whenever an entry is set,
+// removed, evicted, or expired.
+type TypedEvent[K comparable] struct {
+	Kind EventKind
+	Key  K
+}
+
+// Event is the non-generic equivalent of TypedEvent for the string-keyed Cache.
+type Event struct {
+	Kind EventKind
+	Key  string
+}
+
+// subscriberBuffer bounds how many pending events a slow subscriber may
+// accumulate before further events are dropped for it rather than blocking
+// the cache.
+const subscriberBuffer = 16
+
+// Subscribe returns a channel of every Set/Remove/Evict/Expire event and an
+// unsubscribe func that stops delivery and closes the channel. This lets
+// multiple cache instances in a cluster stay coherent, e.g. by forwarding
+// events over Postgres LISTEN/NOTIFY, Redis pub/sub, or a NATS stream.
+func (t *Typed[K, V]) Subscribe() (<-chan TypedEvent[K], func()) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.subscribers == nil {
+		t.subscribers = make(map[int]chan TypedEvent[K])
+	}
+	id := t.nextSubID
+	t.nextSubID++
+	ch := make(chan TypedEvent[K], subscriberBuffer)
+	t.subscribers[id] = ch
+	unsubscribe := func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if ch, found := t.subscribers[id]; found {
+			delete(t.subscribers, id)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// publish fans an event out to every subscriber without blocking; a
+// subscriber that isn't keeping up drops the event rather than stalling the
+// cache. The mutex lock is already held by the caller.
+func (t *Typed[K, V]) publish(kind EventKind, key K) {
+	for _, ch := range t.subscribers {
+		select {
+		case ch <- TypedEvent[K]{Kind: kind, Key: key}:
+		default:
+		}
+	}
+}
+
+// InvalidateFrom spawns a goroutine that Removes each key received from ch,
+// for wiring the cache to an upstream invalidation source (e.g. a Postgres
+// LISTEN/NOTIFY, Redis pub/sub, or NATS subscription) without the module
+// taking a dependency on any of them. The goroutine exits when ch is closed.
+func (t *Typed[K, V]) InvalidateFrom(ch <-chan K) {
+	go func() {
+		for key := range ch {
+			t.Remove(key)
+		}
+	}()
+}
+
+// Subscribe returns a channel of every Set/Remove/Evict/Expire event and an
+// unsubscribe func that stops delivery and closes the channel.
+func (l *Cache) Subscribe() (<-chan Event, func()) {
+	typedEvents, unsubscribe := l.typed.Subscribe()
+	events := make(chan Event, subscriberBuffer)
+	go func() {
+		for e := range typedEvents {
+			events <- Event{Kind: e.Kind, Key: e.Key}
+		}
+		close(events)
+	}()
+	return events, unsubscribe
+}
+
+// InvalidateFrom spawns a goroutine that Removes each key received from ch.
+func (l *Cache) InvalidateFrom(ch <-chan string) {
+	l.typed.InvalidateFrom(ch)
+}
@@ -0,0 +1,88 @@
+// lru project events_test.go
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recvEvent(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}
+	}
+}
+
+func Test_subscribeReceivesSetAndRemove(t *testing.T) {
+	l, err := New(10)
+	assert.Nil(t, err)
+
+	events, unsubscribe := l.Subscribe()
+	defer unsubscribe()
+
+	l.Set("a", "1")
+	e := recvEvent(t, events)
+	assert.Equal(t, EventSet, e.Kind)
+	assert.Equal(t, "a", e.Key)
+
+	l.Remove("a")
+	e = recvEvent(t, events)
+	assert.Equal(t, EventRemove, e.Kind)
+	assert.Equal(t, "a", e.Key)
+}
+
+func Test_subscribeReceivesEvict(t *testing.T) {
+	l, err := New(1)
+	assert.Nil(t, err)
+
+	l.Set("a", "1")
+	events, unsubscribe := l.Subscribe()
+	defer unsubscribe()
+
+	l.Set("b", "2") // evicts "a" to make room for "b"
+
+	e := recvEvent(t, events) // eviction happens before the new entry is inserted
+	assert.Equal(t, EventEvict, e.Kind)
+	assert.Equal(t, "a", e.Key)
+
+	e = recvEvent(t, events)
+	assert.Equal(t, EventSet, e.Kind)
+	assert.Equal(t, "b", e.Key)
+}
+
+func Test_unsubscribeClosesChannel(t *testing.T) {
+	l, err := New(10)
+	assert.Nil(t, err)
+
+	events, unsubscribe := l.Subscribe()
+	unsubscribe()
+
+	_, open := <-events
+	assert.False(t, open)
+}
+
+func Test_invalidateFromRemovesKeys(t *testing.T) {
+	l, err := New(10)
+	assert.Nil(t, err)
+
+	l.Set("a", "1")
+	invalidate := make(chan string)
+	l.InvalidateFrom(invalidate)
+
+	invalidate <- "a"
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if _, found := l.Get("a"); !found {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("key was not invalidated in time")
+}
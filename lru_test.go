@@ -20,8 +20,8 @@ func init() {
 	}
 }
 
-func (pt *PurgeTester) OnPurge(key string, value interface{}) {
-	pt.count = atomic.AddInt64(&pt.count, 1)
+func (pt *PurgeTester) OnPurge(key string, value interface{}, reason PurgeReason) {
+	atomic.AddInt64(&pt.count, 1)
 }
 
 type PurgeTester struct {
@@ -125,8 +125,7 @@ func Test_removeFound(t *testing.T) {
 	l.Set("key3", "val3")
 	found := l.Remove("key2")
 	assert.True(t, found)
-	assert.Equal(t, len(l.data), 2)
-	assert.Equal(t, l.list.Len(), 2)
+	assert.Equal(t, l.Len(), 2)
 }
 
 func Test_removeNotFound(t *testing.T) {
@@ -138,8 +137,7 @@ func Test_removeNotFound(t *testing.T) {
 	l.Set("key3", "val3")
 	found := l.Remove("key4")
 	assert.False(t, found)
-	assert.Equal(t, len(l.data), 3)
-	assert.Equal(t, l.list.Len(), 3)
+	assert.Equal(t, l.Len(), 3)
 }
 
 func Test_listOrdering(t *testing.T) {
@@ -149,19 +147,19 @@ func Test_listOrdering(t *testing.T) {
 	l.Set("a", "a") // list is a
 	l.Set("b", "b") // list is now b,a
 	l.Set("c", "c") // list is now c,b,a
-	assert.Equal(t, l.list.Back().Value.(cacheEntry).value, "a")
-	assert.Equal(t, l.list.Front().Value.(cacheEntry).value, "c")
+	assert.Equal(t, l.typed.list.Back().Value.(typedEntry[string, interface{}]).value, "a")
+	assert.Equal(t, l.typed.list.Front().Value.(typedEntry[string, interface{}]).value, "c")
 
 	val, found := l.Get("a") // list is now a,c,b
 	assert.True(t, found)
 	assert.Equal(t, val, "a")
-	assert.Equal(t, l.list.Front().Value.(cacheEntry).value, "a")
-	assert.Equal(t, l.list.Back().Value.(cacheEntry).value, "b")
+	assert.Equal(t, l.typed.list.Front().Value.(typedEntry[string, interface{}]).value, "a")
+	assert.Equal(t, l.typed.list.Back().Value.(typedEntry[string, interface{}]).value, "b")
 
 	found = l.Remove("a") // list is now c,b
 	assert.True(t, found)
-	assert.Equal(t, l.list.Front().Value.(cacheEntry).value, "c")
-	assert.Equal(t, l.list.Back().Value.(cacheEntry).value, "b")
+	assert.Equal(t, l.typed.list.Front().Value.(typedEntry[string, interface{}]).value, "c")
+	assert.Equal(t, l.typed.list.Back().Value.(typedEntry[string, interface{}]).value, "b")
 }
 
 func Benchmark_insertExpire(b *testing.B) {
@@ -199,7 +197,7 @@ func Benchmark_GetNotFound(b *testing.B) {
 	}
 }
 
-func lruReader(count int, l *lru, wg *sync.WaitGroup) {
+func lruReader(count int, l *Cache, wg *sync.WaitGroup) {
 	for i := 0; i < count; i++ {
 		l.Get(keys[i])
 	}
@@ -0,0 +1,106 @@
+// lru project sharded.go
+package lru
+
+import (
+	"errors"
+	"hash/fnv"
+)
+
+// Sharded spreads a string-keyed cache across N independent sub-caches, each
+// with its own mutex, list, map, and stats, so that concurrent Gets no
+// longer serialize on a single lock. This is the approach leveldb's cache
+// and most production Go caches use for roughly linear read scaling.
+type Sharded struct {
+	shards []*Cache
+}
+
+// NewSharded creates a Sharded cache holding at most limit entries split
+// across shards sub-caches. Capacity is divided evenly between shards, with
+// any remainder distributed to the first shards. limit must be at least
+// shards, since each shard needs room for at least one entry.
+func NewSharded(limit uint, shards int) (*Sharded, error) {
+	if shards <= 0 {
+		return nil, errors.New("shards must be positive")
+	}
+	if limit < uint(shards) {
+		return nil, errors.New("limit must be at least shards")
+	}
+	base := limit / uint(shards)
+	remainder := limit % uint(shards)
+	s := &Sharded{shards: make([]*Cache, shards)}
+	for i := 0; i < shards; i++ {
+		shardLimit := base
+		if uint(i) < remainder {
+			shardLimit++
+		}
+		c, err := New(shardLimit)
+		if err != nil {
+			return nil, err
+		}
+		s.shards[i] = c
+	}
+	return s, nil
+}
+
+// shardFor returns the sub-cache responsible for key, selected by fnv-hashing the key.
+func (s *Sharded) shardFor(key string) *Cache {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return s.shards[h.Sum32()%uint32(len(s.shards))]
+}
+
+// Set adds the value to the shard owning key, returning the previous value as Cache.Set does.
+func (s *Sharded) Set(key string, val interface{}) interface{} {
+	return s.shardFor(key).Set(key, val)
+}
+
+// Get returns the value if it exists and true, otherwise nil and false.
+func (s *Sharded) Get(key string) (interface{}, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Remove removes a key, returning true if the key was found, false if it was not.
+func (s *Sharded) Remove(key string) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// RemoveAll removes every entry from every shard.
+func (s *Sharded) RemoveAll() {
+	for _, c := range s.shards {
+		c.RemoveAll()
+	}
+}
+
+// Len returns the number of entries across all shards.
+func (s *Sharded) Len() int {
+	total := 0
+	for _, c := range s.shards {
+		total += c.Len()
+	}
+	return total
+}
+
+// Stats returns the sum of every shard's Stats.
+func (s *Sharded) Stats() Stats {
+	var agg Stats
+	for _, c := range s.shards {
+		st := c.Stats()
+		agg.Hits += st.Hits
+		agg.Misses += st.Misses
+		agg.Limit += st.Limit
+		agg.Len += st.Len
+		agg.Expired += st.Expired
+		agg.Evicted += st.Evicted
+		agg.Removes += st.Removes
+	}
+	return agg
+}
+
+// HitRate returns a number between 0.0 and 1.0 indicating the percentage of get calls that were found across all shards.
+func (s *Sharded) HitRate() float32 {
+	st := s.Stats()
+	if st.Hits == 0 {
+		return 0.0
+	}
+	return float32(st.Hits) / float32(st.Hits+st.Misses)
+}
@@ -0,0 +1,85 @@
+// lru project sharded_test.go
+package lru
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_shardedSetGet(t *testing.T) {
+	s, err := NewSharded(1000, 4)
+	assert.Nil(t, err)
+
+	for i := 0; i < 100; i++ {
+		s.Set(strconv.Itoa(i), i)
+	}
+	for i := 0; i < 100; i++ {
+		val, found := s.Get(strconv.Itoa(i))
+		assert.True(t, found)
+		assert.Equal(t, i, val)
+	}
+}
+
+func Test_shardedCapacityIsDistributed(t *testing.T) {
+	s, err := NewSharded(10, 4)
+	assert.Nil(t, err)
+
+	total := uint(0)
+	for _, c := range s.shards {
+		total += c.Limit()
+	}
+	assert.Equal(t, uint(10), total)
+}
+
+func Test_shardedStatsAggregate(t *testing.T) {
+	s, err := NewSharded(1000, 4)
+	assert.Nil(t, err)
+
+	for i := 0; i < 100; i++ {
+		s.Set(strconv.Itoa(i), i)
+	}
+	for i := 0; i < 100; i++ {
+		s.Get(strconv.Itoa(i))
+	}
+	s.Get("not-present")
+
+	stats := s.Stats()
+	assert.Equal(t, uint(100), stats.Hits)
+	assert.Equal(t, uint(1), stats.Misses)
+	assert.Equal(t, uint(1000), stats.Limit)
+	assert.Equal(t, uint(100), stats.Len)
+	assert.Equal(t, 100, s.Len())
+}
+
+func Test_shardedRejectsLimitBelowShards(t *testing.T) {
+	_, err := NewSharded(2, 8)
+	assert.NotNil(t, err)
+}
+
+func shardedReader(count int, s *Sharded, wg *sync.WaitGroup) {
+	for i := 0; i < count; i++ {
+		s.Get(keys[i])
+	}
+	wg.Done()
+}
+
+func Benchmark_shardedGetMultiGoRoutines(b *testing.B) {
+	limit := uint(b.N)
+	if limit < 16 {
+		limit = 16
+	}
+	s, _ := NewSharded(limit, 16)
+	for i := 0; i < b.N; i++ {
+		s.Set(keys[i], 100)
+	}
+	wg := &sync.WaitGroup{}
+	b.ResetTimer()
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go shardedReader(b.N, s, wg)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,65 @@
+// lru project sieve_test.go
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sievePolicyEvictsUnvisited(t *testing.T) {
+	c, err := NewWithPolicy(3, SievePolicy)
+	assert.Nil(t, err)
+
+	c.Set("a", "a")
+	c.Set("b", "b")
+	c.Set("c", "c")
+
+	// touching "a" and "b" marks them visited; "c" is left untouched and
+	// should be the one evicted even though it is the most recently inserted.
+	c.Get("a")
+	c.Get("b")
+
+	c.Set("d", "d")
+
+	_, found := c.Get("c")
+	assert.False(t, found)
+
+	for _, key := range []string{"a", "b", "d"} {
+		_, found := c.Get(key)
+		assert.True(t, found, "expected %s to still be cached", key)
+	}
+}
+
+func Test_sieveGetDoesNotReorderList(t *testing.T) {
+	c, err := NewTypedWithPolicy[string, string](10, SievePolicy)
+	assert.Nil(t, err)
+
+	c.Set("a", "a")
+	c.Set("b", "b")
+	c.Set("c", "c")
+	assert.Equal(t, "c", c.list.Front().Value.(typedEntry[string, string]).value)
+
+	c.Get("a")
+	assert.Equal(t, "c", c.list.Front().Value.(typedEntry[string, string]).value)
+	assert.Equal(t, "a", c.list.Back().Value.(typedEntry[string, string]).value)
+}
+
+func Test_sieveHandWrapsToTail(t *testing.T) {
+	c, err := NewTypedWithPolicy[string, string](2, SievePolicy)
+	assert.Nil(t, err)
+
+	c.Set("a", "a")
+	c.Set("b", "b")
+	c.Get("a")
+	c.Get("b")
+
+	// both entries are visited, so eviction must clear both flags, wrap the
+	// hand back to the tail, and evict "a".
+	c.Set("c", "c")
+
+	_, found := c.Get("a")
+	assert.False(t, found)
+	_, found = c.Get("b")
+	assert.True(t, found)
+}
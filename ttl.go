@@ -0,0 +1,63 @@
+// lru project ttl.go
+package lru
+
+import (
+	"errors"
+	"time"
+)
+
+// SetWithTTL is like Set but the entry is treated as a miss, and removed
+// inline, once ttl has elapsed. A ttl of 0 means the entry never expires.
+func (l *Cache) SetWithTTL(key string, val interface{}, ttl time.Duration) interface{} {
+	previousValue, found := l.typed.SetWithTTL(key, val, ttl)
+	if !found {
+		return nil
+	}
+	return previousValue
+}
+
+// EnableReaper starts a background goroutine that walks the cache every
+// interval evicting expired entries, so that rarely-accessed expired keys
+// don't hold capacity until they happen to be Get or evicted for space.
+// Calling the returned stop func halts the goroutine. interval must be
+// positive.
+func (l *Cache) EnableReaper(interval time.Duration) (stop func(), err error) {
+	return l.typed.EnableReaper(interval)
+}
+
+// EnableReaper starts a background goroutine that walks the cache every
+// interval evicting expired entries. Calling the returned stop func halts
+// the goroutine. interval must be positive.
+func (t *Typed[K, V]) EnableReaper(interval time.Duration) (stop func(), err error) {
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				t.reapExpired()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return func() { close(done) }, nil
+}
+
+// reapExpired walks the list evicting any entries whose TTL has passed.
+func (t *Typed[K, V]) reapExpired() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := time.Now()
+	for entry := t.list.Back(); entry != nil; {
+		prev := entry.Prev()
+		if entry.Value.(typedEntry[K, V]).expired(now) {
+			t.remove(entry, PurgeExpired)
+		}
+		entry = prev
+	}
+}
@@ -0,0 +1,60 @@
+// lru project ttl_test.go
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_setWithTTLExpires(t *testing.T) {
+	l, err := New(10)
+	assert.Nil(t, err)
+
+	l.SetWithTTL("a", "a", time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	val, found := l.Get("a")
+	assert.False(t, found)
+	assert.Nil(t, val)
+	assert.Equal(t, uint(1), l.Stats().Expired)
+}
+
+func Test_setWithTTLZeroNeverExpires(t *testing.T) {
+	l, err := New(10)
+	assert.Nil(t, err)
+
+	l.SetWithTTL("a", "a", 0)
+	time.Sleep(5 * time.Millisecond)
+
+	val, found := l.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, "a", val)
+}
+
+func Test_reaperEvictsExpiredEntries(t *testing.T) {
+	c, err := NewTyped[string, string](10)
+	assert.Nil(t, err)
+
+	c.SetWithTTL("a", "a", time.Millisecond)
+	stop, err := c.EnableReaper(2 * time.Millisecond)
+	assert.Nil(t, err)
+	defer stop()
+
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, uint(1), c.Stats().Expired)
+}
+
+func Test_reaperRejectsNonPositiveInterval(t *testing.T) {
+	c, err := NewTyped[string, string](10)
+	assert.Nil(t, err)
+
+	_, err = c.EnableReaper(0)
+	assert.NotNil(t, err)
+
+	_, err = c.EnableReaper(-time.Millisecond)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,387 @@
+// lru project typed.go
+package lru
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// typedEntry is stored as the Value of each list.Element so that eviction
+// can locate the map key without a reverse lookup.
+type typedEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	visited   bool
+	expiresAt time.Time
+	cost      int64
+}
+
+// expired reports whether the entry's TTL, if any, has passed now.
+func (te typedEntry[K, V]) expired(now time.Time) bool {
+	return !te.expiresAt.IsZero() && !te.expiresAt.After(now)
+}
+
+// PurgeReason indicates why an entry was evicted from the cache.
+type PurgeReason int
+
+const (
+	// PurgeCapacity means the entry was evicted to make room for a new one.
+	PurgeCapacity PurgeReason = iota
+	// PurgeExpired means the entry was removed because its TTL had passed.
+	PurgeExpired
+)
+
+// Policy selects the eviction strategy used when a cache is full.
+type Policy int
+
+const (
+	// LRUPolicy evicts the least recently used entry, moving an entry to the
+	// front of the list on every Get.
+	LRUPolicy Policy = iota
+	// SievePolicy evicts using the SIEVE algorithm: Get only sets a visited
+	// flag rather than splicing the list, and eviction sweeps a "hand"
+	// pointer back toward the head looking for an unvisited entry.
+	SievePolicy
+)
+
+// TypedStats is the generic equivalent of Stats.
+type TypedStats struct {
+	Hits        uint
+	Misses      uint
+	Limit       uint
+	Len         uint
+	Expired     uint
+	Evicted     uint
+	Removes     uint
+	Cost        int64
+	CostAdded   int64
+	CostEvicted int64
+}
+
+// TypedPurger is the generic equivalent of the untyped Purger interface, invoked
+// whenever an entry is evicted or expired.
+type TypedPurger[K comparable, V any] interface {
+	OnPurge(key K, value V, reason PurgeReason)
+}
+
+// Typed is a type-safe LRU cache keyed on K storing values of type V.
+// It avoids the interface{} boxing and type assertions required by Cache.
+type Typed[K comparable, V any] struct {
+	data        map[K]*list.Element
+	list        *list.List
+	limit       uint
+	policy      Policy
+	hand        *list.Element
+	maxCost     int64
+	coster      func(key K, val V) int64
+	cost        int64
+	costAdded   int64
+	costEvicted int64
+	mutex       sync.Mutex
+	hits        uint
+	misses      uint
+	expired     uint
+	evicted     uint
+	removes     uint
+	purger      TypedPurger[K, V]
+	subscribers map[int]chan TypedEvent[K]
+	nextSubID   int
+}
+
+// NewTyped creates a new Typed cache holding at most limit entries, evicted
+// with the default LRU policy.
+func NewTyped[K comparable, V any](limit uint) (*Typed[K, V], error) {
+	return NewTypedWithPolicy[K, V](limit, LRUPolicy)
+}
+
+// NewTypedWithPolicy creates a new Typed cache holding at most limit entries,
+// evicted according to policy.
+func NewTypedWithPolicy[K comparable, V any](limit uint, policy Policy) (*Typed[K, V], error) {
+	if limit == 0 {
+		return nil, errors.New("limit must be positive")
+	}
+	t := new(Typed[K, V])
+	t.data = make(map[K]*list.Element)
+	t.list = list.New()
+	t.limit = limit
+	t.policy = policy
+	return t, nil
+}
+
+// NewTypedWithCost creates a new Typed cache whose capacity is governed by
+// cost rather than entry count. Each Set computes the entry's cost via
+// coster, and the cache evicts from the tail until currentCost+newCost <= maxCost.
+func NewTypedWithCost[K comparable, V any](maxCost int64, coster func(key K, val V) int64) (*Typed[K, V], error) {
+	if maxCost <= 0 {
+		return nil, errors.New("maxCost must be positive")
+	}
+	if coster == nil {
+		return nil, errors.New("coster must not be nil")
+	}
+	t := new(Typed[K, V])
+	t.data = make(map[K]*list.Element)
+	t.list = list.New()
+	t.maxCost = maxCost
+	t.coster = coster
+	return t, nil
+}
+
+// RegisterPurger registers a Purger that is notified whenever an entry is evicted.
+func (t *Typed[K, V]) RegisterPurger(purger TypedPurger[K, V]) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.purger = purger
+}
+
+// RemoveAll removes every entry from the cache, notifying the Purger for each one.
+func (t *Typed[K, V]) RemoveAll() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for t.list.Len() > 0 {
+		t.expire()
+	}
+}
+
+// overCapacity reports whether inserting an entry costing pendingCost would
+// exceed the cache's capacity, by cost for a cost-based cache or by entry
+// count otherwise.
+func (t *Typed[K, V]) overCapacity(pendingCost int64) bool {
+	if t.coster != nil {
+		return t.cost+pendingCost > t.maxCost
+	}
+	return t.list.Len() >= int(t.limit)
+}
+
+// expire evicts a single entry according to the configured policy.
+// The mutex lock is already held by the caller.
+func (t *Typed[K, V]) expire() {
+	if t.policy == SievePolicy {
+		t.evictSieve()
+		return
+	}
+	t.evictLRU()
+}
+
+// evictLRU removes the oldest (tail) entry.
+func (t *Typed[K, V]) evictLRU() {
+	entry := t.list.Back()
+	if entry == nil {
+		return
+	}
+	t.remove(entry, PurgeCapacity)
+}
+
+// evictSieve walks the hand pointer backward from where it last stopped,
+// clearing visited flags until it finds an unvisited entry to evict. The
+// hand wraps to the tail whenever it walks off the head of the list.
+func (t *Typed[K, V]) evictSieve() {
+	if t.hand == nil {
+		t.hand = t.list.Back()
+	}
+	for t.hand != nil {
+		te := t.hand.Value.(typedEntry[K, V])
+		if !te.visited {
+			break
+		}
+		te.visited = false
+		t.hand.Value = te
+		t.hand = t.hand.Prev()
+		if t.hand == nil {
+			t.hand = t.list.Back()
+		}
+	}
+	if t.hand == nil {
+		return
+	}
+	victim := t.hand
+	t.hand = victim.Prev()
+	t.remove(victim, PurgeCapacity)
+}
+
+// remove evicts entry for the given reason, notifying the Purger.
+// The mutex lock is already held by the caller.
+func (t *Typed[K, V]) remove(entry *list.Element, reason PurgeReason) {
+	if reason == PurgeExpired {
+		t.expired++
+	} else {
+		t.evicted++
+	}
+	if t.hand == entry {
+		t.hand = entry.Prev()
+	}
+	te := entry.Value.(typedEntry[K, V])
+	if t.coster != nil {
+		t.cost -= te.cost
+		t.costEvicted += te.cost
+	}
+	delete(t.data, te.key)
+	t.list.Remove(entry)
+	if t.purger != nil {
+		t.purger.OnPurge(te.key, te.value, reason)
+	}
+	if reason == PurgeExpired {
+		t.publish(EventExpire, te.key)
+	} else {
+		t.publish(EventEvict, te.key)
+	}
+}
+
+// Set adds the value at the head of the list. Updating an existing key moves it to
+// the head under LRUPolicy; under SievePolicy it marks the entry visited and leaves it in place.
+// If the key was already present, the entry is updated and the previous value is returned
+// along with true. If the key was not already present, the zero value of V and false are returned.
+func (t *Typed[K, V]) Set(key K, val V) (V, bool) {
+	return t.SetWithTTL(key, val, 0)
+}
+
+// SetWithTTL is like Set but the entry is treated as a miss, and inline-expired
+// by Get, once ttl has elapsed. A ttl of 0 means the entry never expires.
+func (t *Typed[K, V]) SetWithTTL(key K, val V, ttl time.Duration) (V, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	var cost int64
+	if t.coster != nil {
+		cost = t.coster(key, val)
+	}
+	// Updating an existing entry never needs to make room for a new list
+	// element, so move/mark it and back out its old cost *before* running
+	// any eviction - otherwise the entry being updated could itself be
+	// chosen as the eviction victim and be gone by the time we look it up.
+	if entry, found := t.data[key]; found {
+		previousValue := entry.Value.(typedEntry[K, V]).value
+		if t.coster != nil {
+			t.cost -= entry.Value.(typedEntry[K, V]).cost
+		}
+		if t.policy == SievePolicy {
+			entry.Value = typedEntry[K, V]{key, val, true, expiresAt, cost}
+		} else {
+			t.list.MoveToFront(entry)
+			entry.Value = typedEntry[K, V]{key, val, false, expiresAt, cost}
+		}
+		// Only a cost-based cache can be over capacity after an in-place
+		// update (entry count is unchanged), and the entry just
+		// moved/marked above is excluded as long as at least one other
+		// entry remains to be evicted instead.
+		if t.coster != nil {
+			for t.list.Len() > 1 && t.overCapacity(cost) {
+				t.expire()
+			}
+			t.cost += cost
+			t.costAdded += cost
+		}
+		t.publish(EventSet, key)
+		return previousValue, true
+	}
+	for t.list.Len() > 0 && t.overCapacity(cost) {
+		t.expire()
+	}
+	entry := t.list.PushFront(typedEntry[K, V]{key: key, value: val, expiresAt: expiresAt, cost: cost})
+	t.data[key] = entry
+	if t.coster != nil {
+		t.cost += cost
+		t.costAdded += cost
+	}
+	t.publish(EventSet, key)
+	var zero V
+	return zero, false
+}
+
+// Get returns the value if it exists and true, otherwise the zero value of V and false.
+// Under LRUPolicy a found entry is moved to the front of the list; under SievePolicy it is
+// only marked visited and keeps its place. An entry whose TTL has
+// passed is treated as a miss and removed inline.
+func (t *Typed[K, V]) Get(key K) (V, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if entry, found := t.data[key]; found {
+		te := entry.Value.(typedEntry[K, V])
+		if te.expired(time.Now()) {
+			t.misses++
+			t.remove(entry, PurgeExpired)
+			var zero V
+			return zero, false
+		}
+		t.hits++
+		if t.policy == SievePolicy {
+			te.visited = true
+			entry.Value = te
+		} else {
+			t.list.MoveToFront(entry)
+		}
+		return te.value, true
+	}
+	t.misses++
+	var zero V
+	return zero, false
+}
+
+// Remove removes a key, returning true if the key was found, false if it was not.
+func (t *Typed[K, V]) Remove(key K) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.removes++
+	if entry, found := t.data[key]; found {
+		if t.hand == entry {
+			t.hand = entry.Prev()
+		}
+		if t.coster != nil {
+			t.cost -= entry.Value.(typedEntry[K, V]).cost
+		}
+		t.list.Remove(entry)
+		delete(t.data, key)
+		t.publish(EventRemove, key)
+		return true
+	}
+	return false
+}
+
+// Stats returns a stats structure containing information on the cache hits, misses, max size, current size, expired entries, capacity evictions, Remove calls (whether or not the key was found), and (for cost-based caches) cost accounting.
+func (t *Typed[K, V]) Stats() TypedStats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return TypedStats{t.hits, t.misses, t.limit, uint(t.list.Len()), t.expired, t.evicted, t.removes, t.cost, t.costAdded, t.costEvicted}
+}
+
+// ResetStats resets the hit, miss, expired, evicted, remove, and cost counters.
+func (t *Typed[K, V]) ResetStats() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.hits = 0
+	t.misses = 0
+	t.expired = 0
+	t.evicted = 0
+	t.removes = 0
+	t.costAdded = 0
+	t.costEvicted = 0
+}
+
+// Limit returns the maximum number of entries that may be kept in the cache.
+// A cache created with NewTypedWithCost has no entry limit and returns 0.
+func (t *Typed[K, V]) Limit() uint {
+	return t.limit
+}
+
+// Len returns the number of entries in the cache.
+func (t *Typed[K, V]) Len() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.list.Len()
+}
+
+// HitRate returns a number between 0.0 and 1.0 indicating the percentage of get calls that were found in the cache.
+func (t *Typed[K, V]) HitRate() float32 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.hits == 0 {
+		return 0.0
+	}
+	return float32(t.hits) / float32(t.hits+t.misses)
+}
@@ -0,0 +1,101 @@
+// lru project typed_test.go
+package lru
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TypedPurgeTester struct {
+	purged []string
+}
+
+func (pt *TypedPurgeTester) OnPurge(key string, value int, reason PurgeReason) {
+	pt.purged = append(pt.purged, key)
+}
+
+func Test_typedSimpleFoundCase(t *testing.T) {
+	c, err := NewTyped[string, int](10)
+	assert.Nil(t, err)
+	c.Set("a", 1)
+	val, found := c.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 1, val)
+}
+
+func Test_typedSimpleNotFoundCase(t *testing.T) {
+	c, err := NewTyped[string, int](10)
+	assert.Nil(t, err)
+	_, found := c.Get("a")
+	assert.False(t, found)
+}
+
+func Test_typedExpireCase(t *testing.T) {
+	size := 10
+	c, err := NewTyped[int, string](uint(size))
+	assert.Nil(t, err)
+
+	c.Set(-1, "willExpire")
+	val, found := c.Get(-1)
+	assert.True(t, found)
+	assert.Equal(t, "willExpire", val)
+
+	for i := 0; i < size; i++ {
+		c.Set(i, strconv.Itoa(i))
+	}
+
+	_, found = c.Get(-1)
+	assert.False(t, found)
+}
+
+func Test_typedUpdate(t *testing.T) {
+	c, err := NewTyped[string, int](10)
+	assert.Nil(t, err)
+
+	prev, found := c.Set("a", 1)
+	assert.False(t, found)
+	assert.Equal(t, 0, prev)
+
+	prev, found = c.Set("a", 2)
+	assert.True(t, found)
+	assert.Equal(t, 1, prev)
+	assert.Equal(t, 1, c.Len())
+}
+
+func Test_typedRemove(t *testing.T) {
+	c, err := NewTyped[string, int](10)
+	assert.Nil(t, err)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	assert.True(t, c.Remove("a"))
+	assert.False(t, c.Remove("a"))
+	assert.Equal(t, 1, c.Len())
+}
+
+func Test_typedPurge(t *testing.T) {
+	pt := &TypedPurgeTester{}
+	c, err := NewTyped[string, int](1)
+	assert.Nil(t, err)
+	c.RegisterPurger(pt)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	assert.Equal(t, []string{"a"}, pt.purged)
+}
+
+func Test_typedHitRate(t *testing.T) {
+	c, err := NewTyped[string, int](10)
+	assert.Nil(t, err)
+
+	assert.Equal(t, float32(0.0), c.HitRate())
+
+	c.Set("a", 1)
+	c.Get("a")
+	assert.Equal(t, float32(1.0), c.HitRate())
+
+	c.Get("b")
+	assert.Equal(t, float32(0.5), c.HitRate())
+}